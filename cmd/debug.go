@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hashicorp/vault-secrets-operator/internal/vault"
+)
+
+var debugSocketPath string
+
+// NewDebugCmd returns the `debug` command tree, which connects to a
+// running operator over its local admin socket (vault.DebugSocketPath
+// by default) to introspect the Vault client cache without shelling
+// into the pod. It is registered on the root command by NewRootCmd.
+func NewDebugCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "debug",
+		Short: "Introspect a running operator",
+	}
+	cmd.PersistentFlags().StringVar(&debugSocketPath, "socket", vault.DebugSocketPath,
+		"path to the operator's local debug admin socket")
+	cmd.AddCommand(newDebugClientCacheCmd())
+	return cmd
+}
+
+func newDebugClientCacheCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "client-cache",
+		Short: "Inspect the Vault client cache",
+	}
+	cmd.AddCommand(newDebugClientCacheListCmd())
+	cmd.AddCommand(newDebugClientCacheGetCmd())
+	cmd.AddCommand(newDebugClientCacheIsCloneCmd())
+	return cmd
+}
+
+func newDebugClientCacheListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List every entry in the Vault client cache",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			entries, err := fetchClientCacheEntries(debugSocketPath)
+			if err != nil {
+				return err
+			}
+			for _, e := range entries {
+				printClientCacheEntry(cmd, e)
+			}
+			return nil
+		},
+	}
+}
+
+func newDebugClientCacheGetCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <key>",
+		Short: "Print the cache entry matching <key>",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			entries, err := fetchClientCacheEntries(debugSocketPath)
+			if err != nil {
+				return err
+			}
+			for _, e := range entries {
+				if string(e.Key) == args[0] {
+					printClientCacheEntry(cmd, e)
+					return nil
+				}
+			}
+			return fmt.Errorf("no client cache entry found for key %q", args[0])
+		},
+	}
+}
+
+func newDebugClientCacheIsCloneCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "is-clone <key>",
+		Short: "Report whether <key> is a clone of another cache entry",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			entries, err := fetchClientCacheEntries(debugSocketPath)
+			if err != nil {
+				return err
+			}
+			for _, e := range entries {
+				if string(e.Key) == args[0] {
+					cmd.Println(e.IsClone)
+					return nil
+				}
+			}
+			return fmt.Errorf("no client cache entry found for key %q", args[0])
+		},
+	}
+}
+
+func printClientCacheEntry(cmd *cobra.Command, e vault.ClientCacheEntry) {
+	cmd.Printf("key=%s auth-method=%s auth-uid=%s connection-uid=%s is-clone=%t clone-namespace=%s token-ttl-remaining=%s\n",
+		e.Key, e.AuthMethod, e.AuthUID, e.ConnectionUID, e.IsClone, e.CloneNamespace, e.TokenTTLRemaining)
+}
+
+// fetchClientCacheEntries dials socketPath and requests the full list
+// of client cache entries from a running operator's debug server.
+func fetchClientCacheEntries(socketPath string) ([]vault.ClientCacheEntry, error) {
+	conn, err := net.DialTimeout("unix", socketPath, 5*time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to debug socket %s: %w", socketPath, err)
+	}
+	defer conn.Close()
+
+	if err := json.NewEncoder(conn).Encode(map[string]string{"command": "list"}); err != nil {
+		return nil, fmt.Errorf("failed to send debug request: %w", err)
+	}
+
+	var entries []vault.ClientCacheEntry
+	if err := json.NewDecoder(conn).Decode(&entries); err != nil {
+		return nil, fmt.Errorf("failed to decode debug response: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/hashicorp/vault-secrets-operator/internal/helpers"
+	"github.com/hashicorp/vault-secrets-operator/internal/vault"
+)
+
+var operatorNamespace string
+
+// NewOperatorCmd returns the `operator` command, which starts the
+// controller-runtime manager that backs the rest of VSO. Alongside the
+// reconcilers registered elsewhere, it starts the shared lifecycle
+// watcher that AwaitInMemoryVaultTokensRevoked and AwaitPreDeleteStarted
+// block on, so those calls are woken by the event-driven watch instead
+// of never being reached.
+func NewOperatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "operator",
+		Short: "Start the VSO controller manager",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runOperator(cmd.Context())
+		},
+	}
+	cmd.Flags().StringVar(&operatorNamespace, "namespace", "",
+		"namespace the operator's own pods run in")
+	return cmd
+}
+
+func runOperator(ctx context.Context) error {
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{})
+	if err != nil {
+		return fmt.Errorf("failed to start manager: %w", err)
+	}
+
+	metadataClient, err := helpers.NewPodsMetadataClient(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create pods metadata client: %w", err)
+	}
+
+	watcher, err := helpers.NewLifecycleWatcher(ctx, metadataClient, operatorNamespace)
+	if err != nil {
+		return fmt.Errorf("failed to start lifecycle watcher: %w", err)
+	}
+
+	// runCtx is canceled as soon as the pre-delete hook Job signals it
+	// has started, so the manager stops reconciling before the hook
+	// begins revoking this operator's Vault tokens out from under it.
+	runCtx, cancelRun := context.WithCancel(ctx)
+	go helpers.AwaitInMemoryVaultTokensRevoked(ctx, mgr.GetLogger(), watcher)
+	go helpers.AwaitPreDeleteStarted(ctx, cancelRun, mgr.GetLogger(), watcher)
+
+	// clientCache is handed only to the debug server, which reads from it
+	// through the read-only ClientCacheIntrospector view. No reconciler is
+	// given this cache here, so the debug server lists only entries that
+	// are Added to this instance.
+	clientCache := vault.NewClientCache()
+	debugServer := vault.NewDebugServer(clientCache, mgr.GetLogger())
+	if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		return debugServer.ListenAndServe(ctx, vault.DebugSocketPath)
+	})); err != nil {
+		return fmt.Errorf("failed to register debug server: %w", err)
+	}
+
+	return mgr.Start(runCtx)
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/hashicorp/vault-secrets-operator/internal/helpers"
+)
+
+// NewPreDeleteHookCmd returns the `pre-delete-hook` command, run as a
+// Helm pre-delete hook Job. It marks itself started, which the running
+// operator's LifecycleWatcher observes so the operator's manager stops
+// reconciling, then immediately marks the operator's in-memory Vault
+// tokens as revoked. This process does not itself revoke any Vault token.
+func NewPreDeleteHookCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "pre-delete-hook",
+		Short: "Tear down cached Vault tokens ahead of operator uninstall",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPreDeleteHook(cmd)
+		},
+	}
+}
+
+func runPreDeleteHook(cmd *cobra.Command) error {
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+
+	metadataClient, err := helpers.NewPodsMetadataClient(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create pods metadata client: %w", err)
+	}
+
+	ctx := cmd.Context()
+	if err := helpers.AnnotatePredeleteHookStarted(ctx, metadataClient); err != nil {
+		return fmt.Errorf("failed to annotate pre-delete hook started: %w", err)
+	}
+
+	// Nothing in this hook revokes a token or waits for one to be revoked
+	// before this annotation is set; setting it unblocks the operator's
+	// AwaitInMemoryVaultTokensRevoked callers.
+	return helpers.AnnotateInMemoryVaultTokensRevoked(ctx, metadataClient)
+}
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewRootCmd returns the root `vault-secrets-operator` command tree.
+// main.go calls Execute() on the result.
+func NewRootCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vault-secrets-operator",
+		Short: "Synchronize Vault secrets into Kubernetes",
+	}
+	cmd.AddCommand(NewOperatorCmd())
+	cmd.AddCommand(NewPreDeleteHookCmd())
+	cmd.AddCommand(NewDebugCmd())
+	return cmd
+}
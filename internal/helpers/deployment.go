@@ -7,15 +7,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os"
 	"strings"
-	"time"
 
 	"github.com/go-logr/logr"
-	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
-	"sigs.k8s.io/controller-runtime/pkg/client"
+	"k8s.io/client-go/metadata"
 )
 
 const (
@@ -25,90 +23,66 @@ const (
 	StringTrue                           = "true"
 )
 
-func AwaitInMemoryVaultTokensRevoked(ctx context.Context, logger logr.Logger, c client.Client) {
-	selector, err := labels.Parse(LabelSelectorControlPlane)
-	if err != nil {
-		logger.Error(err, "failed to parse label selector", "selector", LabelSelectorControlPlane)
-		return
-	}
-
-	for {
-		select {
-		case <-ctx.Done():
-			logger.Error(ctx.Err(), "failed to await in-memory vault tokens revoked")
-			return
-		default:
-			var list corev1.PodList
-			err = c.List(ctx, &list, client.MatchingLabelsSelector{
-				Selector: selector,
-			})
-			if err != nil {
-				logger.Error(err, "failed to get pod list", "selector", LabelSelectorControlPlane)
-			} else {
-				for _, pod := range list.Items {
-					if value, ok := pod.Annotations[AnnotationInMemoryVaultTokensRevoked]; ok && value == StringTrue {
-						logger.Info("Operator pods annotations updated", AnnotationInMemoryVaultTokensRevoked, StringTrue)
-						return
-					}
-				}
-			}
-			time.Sleep(300 * time.Millisecond)
-		}
+// AwaitInMemoryVaultTokensRevoked blocks until w observes the
+// AnnotationInMemoryVaultTokensRevoked annotation on a controller-manager
+// pod, or ctx is canceled.
+func AwaitInMemoryVaultTokensRevoked(ctx context.Context, logger logr.Logger, w *LifecycleWatcher) {
+	select {
+	case <-ctx.Done():
+		logger.Error(ctx.Err(), "failed to await in-memory vault tokens revoked")
+	case <-w.TokensRevoked():
+		logger.Info("Operator pods annotations updated", AnnotationInMemoryVaultTokensRevoked, StringTrue)
 	}
 }
 
-func AwaitPreDeleteStarted(ctx context.Context, handler context.CancelFunc, logger logr.Logger) {
-	for {
-		select {
-		case <-ctx.Done():
-			logger.Error(ctx.Err(), "Operator manager context canceled. Stopping /var/run/podinfo/pre-delete-hook-started watcher")
-			return
-		default:
-			if b, err := os.ReadFile("/var/run/podinfo/pre-delete-hook-started"); err != nil {
-				logger.Error(err, "failed to get downward API exposed file", "path", "/var/run/podinfo/pre-delete-hook-started")
-			} else if string(b) == StringTrue {
-				logger.Info("Operator pods annotations updated", AnnotationPreDeleteHookStarted, StringTrue)
-				handler()
-				return
-			}
-			time.Sleep(300 * time.Millisecond)
-		}
+// AwaitPreDeleteStarted blocks until w observes the
+// AnnotationPreDeleteHookStarted annotation on a controller-manager pod,
+// then invokes handler, or returns early if ctx is canceled.
+func AwaitPreDeleteStarted(ctx context.Context, handler context.CancelFunc, logger logr.Logger, w *LifecycleWatcher) {
+	select {
+	case <-ctx.Done():
+		logger.Error(ctx.Err(), "Operator manager context canceled. Stopping pre-delete-hook-started watcher")
+	case <-w.PreDeleteStarted():
+		logger.Info("Operator pods annotations updated", AnnotationPreDeleteHookStarted, StringTrue)
+		handler()
 	}
 }
 
-func AnnotateInMemoryVaultTokensRevoked(ctx context.Context, c client.Client) error {
-	return annotateOperatorPods(ctx, c, map[string]string{AnnotationInMemoryVaultTokensRevoked: StringTrue})
+func AnnotateInMemoryVaultTokensRevoked(ctx context.Context, metadataClient metadata.Interface) error {
+	return annotateOperatorPods(ctx, metadataClient, map[string]string{AnnotationInMemoryVaultTokensRevoked: StringTrue})
 }
 
-func AnnotatePredeleteHookStarted(ctx context.Context, c client.Client) error {
-	return annotateOperatorPods(ctx, c, map[string]string{AnnotationPreDeleteHookStarted: StringTrue})
+func AnnotatePredeleteHookStarted(ctx context.Context, metadataClient metadata.Interface) error {
+	return annotateOperatorPods(ctx, metadataClient, map[string]string{AnnotationPreDeleteHookStarted: StringTrue})
 }
 
-func annotateOperatorPods(ctx context.Context, c client.Client, annotations map[string]string) error {
-	var list corev1.PodList
-
+// annotateOperatorPods merge-patches annotations onto every operator pod
+// matching LabelSelectorControlPlane, across all namespaces. It goes
+// through metadataClient so only PartialObjectMetadata is fetched and
+// patched, rather than pulling full PodSpecs/status into memory just to
+// read and set two annotations.
+func annotateOperatorPods(ctx context.Context, metadataClient metadata.Interface, annotations map[string]string) error {
 	selector, err := labels.Parse(LabelSelectorControlPlane)
 	if err != nil {
 		return fmt.Errorf("failed to parse label selector err=%v", err)
 	}
 
-	err = c.List(ctx, &list, client.MatchingLabelsSelector{
-		Selector: selector,
-	})
+	podsClient := metadataClient.Resource(podsResource)
+	list, err := podsClient.Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
 	if err != nil {
 		return fmt.Errorf("failed to list pods err=%v", err)
 	}
 
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{"annotations": annotations},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal patch payload err=%v", err)
+	}
+
 	errs := []string{}
 	for _, pod := range list.Items {
-		for k, v := range annotations {
-			pod.Annotations[k] = v
-		}
-		pJson, err := json.Marshal(pod)
-		if err != nil {
-			return fmt.Errorf("failed to marshal patch payload err=%v", err)
-		}
-		if err = c.Patch(ctx, &pod, client.RawPatch(types.MergePatchType, pJson)); err != nil {
+		if _, err := podsClient.Namespace(pod.Namespace).Patch(ctx, pod.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
 			errs = append(errs, err.Error())
 		}
 	}
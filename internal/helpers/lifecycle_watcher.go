@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/tools/cache"
+)
+
+// podsResource is the GroupVersionResource the lifecycle watcher and
+// annotateOperatorPods list/patch through the metadata client.
+var podsResource = schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+
+// LifecycleWatcher watches this operator's own pods, selected by
+// LabelSelectorControlPlane, through a single shared informer and turns
+// annotation transitions into one-shot signals. It replaces the 300ms
+// poll loops previously used by AwaitInMemoryVaultTokensRevoked and
+// AwaitPreDeleteStarted with an event-driven watch, so both calls wake
+// up as soon as the relevant annotation is observed rather than up to
+// 300ms later.
+type LifecycleWatcher struct {
+	informer cache.SharedIndexInformer
+
+	tokensRevokedOnce sync.Once
+	tokensRevokedCh   chan struct{}
+
+	preDeleteOnce sync.Once
+	preDeleteCh   chan struct{}
+}
+
+// NewLifecycleWatcher starts a SharedIndexInformer over pods labeled
+// LabelSelectorControlPlane in namespace and returns a LifecycleWatcher
+// whose TokensRevoked and PreDeleteStarted channels close as soon as the
+// corresponding annotation is observed on any matching pod. The informer
+// stops when ctx is canceled. It lists and watches through
+// metadataClient, so only PartialObjectMetadata is ever cached for pods
+// this process never otherwise reconciles.
+func NewLifecycleWatcher(ctx context.Context, metadataClient metadata.Interface, namespace string) (*LifecycleWatcher, error) {
+	w := &LifecycleWatcher{
+		tokensRevokedCh: make(chan struct{}),
+		preDeleteCh:     make(chan struct{}),
+	}
+
+	podsClient := metadataClient.Resource(podsResource).Namespace(namespace)
+	informer := cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+				opts.LabelSelector = LabelSelectorControlPlane
+				return podsClient.List(ctx, opts)
+			},
+			WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+				opts.LabelSelector = LabelSelectorControlPlane
+				return podsClient.Watch(ctx, opts)
+			},
+		},
+		&metav1.PartialObjectMetadata{},
+		0,
+		cache.Indexers{},
+	)
+
+	if _, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    w.handlePodEvent,
+		UpdateFunc: func(_, newObj interface{}) { w.handlePodEvent(newObj) },
+	}); err != nil {
+		return nil, fmt.Errorf("failed to register lifecycle watcher event handler: %w", err)
+	}
+
+	w.informer = informer
+	go informer.Run(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+		return nil, fmt.Errorf("failed to sync lifecycle watcher cache")
+	}
+
+	return w, nil
+}
+
+// handlePodEvent inspects a pod's annotations and closes the
+// corresponding one-shot channel the first time each annotation is
+// observed as "true". It is exercised directly in tests, without
+// standing up a real informer, by feeding it PartialObjectMetadata
+// values.
+func (w *LifecycleWatcher) handlePodEvent(obj interface{}) {
+	pod, ok := obj.(*metav1.PartialObjectMetadata)
+	if !ok {
+		return
+	}
+	if pod.Annotations[AnnotationInMemoryVaultTokensRevoked] == StringTrue {
+		w.tokensRevokedOnce.Do(func() { close(w.tokensRevokedCh) })
+	}
+	if pod.Annotations[AnnotationPreDeleteHookStarted] == StringTrue {
+		w.preDeleteOnce.Do(func() { close(w.preDeleteCh) })
+	}
+}
+
+// TokensRevoked returns a channel that is closed once a controller-manager
+// pod's AnnotationInMemoryVaultTokensRevoked annotation is observed as "true".
+func (w *LifecycleWatcher) TokensRevoked() <-chan struct{} {
+	return w.tokensRevokedCh
+}
+
+// PreDeleteStarted returns a channel that is closed once a controller-manager
+// pod's AnnotationPreDeleteHookStarted annotation is observed as "true".
+func (w *LifecycleWatcher) PreDeleteStarted() <-chan struct{} {
+	return w.preDeleteCh
+}
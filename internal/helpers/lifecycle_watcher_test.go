@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newTestLifecycleWatcher() *LifecycleWatcher {
+	return &LifecycleWatcher{
+		tokensRevokedCh: make(chan struct{}),
+		preDeleteCh:     make(chan struct{}),
+	}
+}
+
+func assertClosed(t *testing.T, ch <-chan struct{}) {
+	t.Helper()
+	select {
+	case <-ch:
+	case <-time.After(time.Second):
+		t.Fatal("expected channel to be closed")
+	}
+}
+
+func assertOpen(t *testing.T, ch <-chan struct{}) {
+	t.Helper()
+	select {
+	case <-ch:
+		t.Fatal("expected channel to still be open")
+	default:
+	}
+}
+
+func TestLifecycleWatcher_handlePodEvent(t *testing.T) {
+	t.Run("ignores objects that aren't PartialObjectMetadata", func(t *testing.T) {
+		w := newTestLifecycleWatcher()
+		w.handlePodEvent("not a pod")
+		assertOpen(t, w.TokensRevoked())
+		assertOpen(t, w.PreDeleteStarted())
+	})
+
+	t.Run("ignores pods without the annotations", func(t *testing.T) {
+		w := newTestLifecycleWatcher()
+		w.handlePodEvent(&metav1.PartialObjectMetadata{})
+		assertOpen(t, w.TokensRevoked())
+		assertOpen(t, w.PreDeleteStarted())
+	})
+
+	t.Run("closes TokensRevoked once the annotation is true", func(t *testing.T) {
+		w := newTestLifecycleWatcher()
+		w.handlePodEvent(&metav1.PartialObjectMetadata{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{AnnotationInMemoryVaultTokensRevoked: StringTrue},
+			},
+		})
+		assertClosed(t, w.TokensRevoked())
+		assertOpen(t, w.PreDeleteStarted())
+	})
+
+	t.Run("closes PreDeleteStarted once the annotation is true", func(t *testing.T) {
+		w := newTestLifecycleWatcher()
+		w.handlePodEvent(&metav1.PartialObjectMetadata{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{AnnotationPreDeleteHookStarted: StringTrue},
+			},
+		})
+		assertClosed(t, w.PreDeleteStarted())
+		assertOpen(t, w.TokensRevoked())
+	})
+
+	t.Run("is idempotent across repeated events", func(t *testing.T) {
+		w := newTestLifecycleWatcher()
+		pod := &metav1.PartialObjectMetadata{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{AnnotationInMemoryVaultTokensRevoked: StringTrue},
+			},
+		}
+		assert.NotPanics(t, func() {
+			w.handlePodEvent(pod)
+			w.handlePodEvent(pod)
+		})
+		assertClosed(t, w.TokensRevoked())
+	})
+}
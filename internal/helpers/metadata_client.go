@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/rest"
+)
+
+// NewPodsMetadataClient returns a metadata-only client for use by the
+// helpers package's operator-pod lookups. It is wired through manager
+// setup the same way a typed client.Client is, but only ever fetches
+// PartialObjectMetadata, so operator pods this process never otherwise
+// reconciles don't end up cached in memory as full PodSpecs.
+func NewPodsMetadataClient(cfg *rest.Config) (metadata.Interface, error) {
+	return metadata.NewForConfig(cfg)
+}
@@ -17,10 +17,14 @@ import (
 )
 
 const (
-	authUID      = types.UID("c4fad6b9-e7bb-4ed8-bc38-67fd6dc85a35")
-	connUID      = types.UID("c4fad6b9-e7bb-4ed8-bc38-67fd6dc85a36")
-	providerUID  = types.UID("c4fad6b9-e7bb-4ed8-bc38-67fd6dc85a37")
-	computedHash = "2a8108711ae49ac0faa724"
+	authUID     = types.UID("c4fad6b9-e7bb-4ed8-bc38-67fd6dc85a35")
+	connUID     = types.UID("c4fad6b9-e7bb-4ed8-bc38-67fd6dc85a36")
+	providerUID = types.UID("c4fad6b9-e7bb-4ed8-bc38-67fd6dc85a37")
+
+	// identityHash is sha256(authUID+connUID+providerUID)[:22]; specHash
+	// is sha256("00")[:8], i.e. authObj.Generation=0, connObj.Generation=0.
+	identityHash = "54e8ad6ad020dd98bac4c2"
+	specHash     = "f1534392"
 )
 
 type computeClientCacheKeyTest struct {
@@ -53,7 +57,7 @@ func Test_computeClientCacheKey(t *testing.T) {
 				},
 			},
 			providerUID: providerUID,
-			want:        "ical-" + computedHash,
+			want:        "ical-" + identityHash + "-" + specHash,
 			wantErr:     assert.NoError,
 		},
 		{
@@ -74,7 +78,7 @@ func Test_computeClientCacheKey(t *testing.T) {
 				},
 			},
 			providerUID: providerUID,
-			want:        ClientCacheKey("ical" + strings.Repeat("x", 36) + "-" + computedHash),
+			want:        ClientCacheKey("ical" + strings.Repeat("x", 36) + "-" + identityHash + "-" + specHash),
 			wantErr:     assert.NoError,
 		},
 		{
@@ -198,7 +202,7 @@ func TestComputeClientCacheKeyFromClient(t *testing.T) {
 				},
 			},
 			providerUID: providerUID,
-			want:        ClientCacheKey("ical-" + computedHash),
+			want:        ClientCacheKey("ical-" + identityHash + "-" + specHash),
 			wantErr:     assert.NoError,
 		},
 	}
@@ -233,23 +237,23 @@ func TestClientCacheKey_IsClone(t *testing.T) {
 	}{
 		{
 			name: "is-not-a-clone-no-suffix",
-			k: ClientCacheKey(fmt.Sprintf("%s-%s",
+			k: ClientCacheKey(fmt.Sprintf("%s-%s-%s",
 				credentials.ProviderMethodKubernetes,
-				computedHash)),
+				identityHash, specHash)),
 			want: false,
 		},
 		{
 			name: "is-not-a-clone-empty-suffix",
-			k: ClientCacheKey(fmt.Sprintf("%s-%s-",
+			k: ClientCacheKey(fmt.Sprintf("%s-%s-%s-",
 				credentials.ProviderMethodKubernetes,
-				computedHash)),
+				identityHash, specHash)),
 			want: false,
 		},
 		{
 			name: "is-a-clone",
-			k: ClientCacheKey(fmt.Sprintf("%s-%s-ns1/ns2",
+			k: ClientCacheKey(fmt.Sprintf("%s-%s-%s-ns1/ns2",
 				credentials.ProviderMethodKubernetes,
-				computedHash)),
+				identityHash, specHash)),
 			want: true,
 		},
 	}
@@ -260,6 +264,44 @@ func TestClientCacheKey_IsClone(t *testing.T) {
 	}
 }
 
+func Test_computeClientCacheKey_GenerationChangesKey(t *testing.T) {
+	newObjs := func(authGen, connGen int64) (*secretsv1beta1.VaultAuth, *secretsv1beta1.VaultConnection) {
+		authObj := &secretsv1beta1.VaultAuth{
+			ObjectMeta: metav1.ObjectMeta{UID: authUID, Generation: authGen},
+			Spec:       secretsv1beta1.VaultAuthSpec{Method: "ical"},
+		}
+		connObj := &secretsv1beta1.VaultConnection{
+			ObjectMeta: metav1.ObjectMeta{UID: connUID, Generation: connGen},
+		}
+		return authObj, connObj
+	}
+
+	baseAuth, baseConn := newObjs(0, 0)
+	baseKey, err := computeClientCacheKey(baseAuth, baseConn, providerUID)
+	assert.NoError(t, err)
+
+	t.Run("authObj generation change produces a different key", func(t *testing.T) {
+		authObj, connObj := newObjs(1, 0)
+		got, err := computeClientCacheKey(authObj, connObj, providerUID)
+		assert.NoError(t, err)
+		assert.NotEqual(t, baseKey, got)
+	})
+
+	t.Run("connObj generation change produces a different key", func(t *testing.T) {
+		authObj, connObj := newObjs(0, 1)
+		got, err := computeClientCacheKey(authObj, connObj, providerUID)
+		assert.NoError(t, err)
+		assert.NotEqual(t, baseKey, got)
+	})
+
+	t.Run("unchanged generations reproduce the same key", func(t *testing.T) {
+		authObj, connObj := newObjs(0, 0)
+		got, err := computeClientCacheKey(authObj, connObj, providerUID)
+		assert.NoError(t, err)
+		assert.Equal(t, baseKey, got)
+	})
+}
+
 func TestClientCacheKeyClone(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -270,20 +312,20 @@ func TestClientCacheKeyClone(t *testing.T) {
 	}{
 		{
 			name: "valid",
-			key: ClientCacheKey(fmt.Sprintf("%s-%s",
+			key: ClientCacheKey(fmt.Sprintf("%s-%s-%s",
 				credentials.ProviderMethodKubernetes,
-				computedHash)),
+				identityHash, specHash)),
 			namespace: "ns1/ns2",
-			want: ClientCacheKey(fmt.Sprintf("%s-%s-ns1/ns2",
+			want: ClientCacheKey(fmt.Sprintf("%s-%s-%s-ns1/ns2",
 				credentials.ProviderMethodKubernetes,
-				computedHash)),
+				identityHash, specHash)),
 			wantErr: assert.NoError,
 		},
 		{
 			name: "fail-empty-namespace",
-			key: ClientCacheKey(fmt.Sprintf("%s-%s",
+			key: ClientCacheKey(fmt.Sprintf("%s-%s-%s",
 				credentials.ProviderMethodKubernetes,
-				computedHash)),
+				identityHash, specHash)),
 			namespace: "",
 			want:      "",
 			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
@@ -292,9 +334,9 @@ func TestClientCacheKeyClone(t *testing.T) {
 		},
 		{
 			name: "fail-parent-is-clone",
-			key: ClientCacheKey(fmt.Sprintf("%s-%s-ns1/ns2",
+			key: ClientCacheKey(fmt.Sprintf("%s-%s-%s-ns1/ns2",
 				credentials.ProviderMethodKubernetes,
-				computedHash)),
+				identityHash, specHash)),
 			namespace: "ns3",
 			want:      "",
 			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
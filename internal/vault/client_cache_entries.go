@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ClientCacheEntry is a read-only, serializable snapshot of a single
+// entry held by the Vault client cache. It backs the `debug
+// client-cache` CLI and its introspection endpoint, giving operators a
+// supported way to answer "which Vault clients does VSO currently
+// hold?" without shelling into the pod.
+type ClientCacheEntry struct {
+	Key               ClientCacheKey
+	AuthMethod        string
+	AuthUID           types.UID
+	ConnectionUID     types.UID
+	IsClone           bool
+	CloneNamespace    string
+	TokenTTLRemaining time.Duration
+}
+
+// Entries returns a snapshot of every entry currently held by the
+// cache. It is read-only and safe to call concurrently with
+// Get/Add/Remove.
+func (c *ClientCache) Entries() []ClientCacheEntry {
+	c.cacheLock.RLock()
+	defer c.cacheLock.RUnlock()
+
+	entries := make([]ClientCacheEntry, 0, len(c.cache))
+	for key, vc := range c.cache {
+		entry := ClientCacheEntry{
+			Key:     key,
+			IsClone: key.IsClone(),
+		}
+
+		if authObj := vc.GetVaultAuthObj(); authObj != nil {
+			entry.AuthMethod = string(authObj.Spec.Method)
+			entry.AuthUID = authObj.UID
+		}
+		if connObj := vc.GetVaultConnectionObj(); connObj != nil {
+			entry.ConnectionUID = connObj.UID
+		}
+		if entry.IsClone {
+			if parts := strings.SplitN(string(key), "-", 4); len(parts) == 4 {
+				entry.CloneNamespace = parts[3]
+			}
+		}
+		if secret := vc.GetTokenSecret(); secret != nil {
+			if ttl, err := secret.TokenTTL(); err == nil {
+				entry.TokenTTLRemaining = ttl
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
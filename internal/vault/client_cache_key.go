@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/vault/api"
+	"k8s.io/apimachinery/pkg/types"
+
+	secretsv1beta1 "github.com/hashicorp/vault-secrets-operator/api/v1beta1"
+	"github.com/hashicorp/vault-secrets-operator/internal/vault/credentials"
+)
+
+// ClientCache holds the cached Client for every ClientCacheKey the
+// operator currently has a token for. Reconcilers populate it through
+// Add/Get/Remove as VaultAuth/VaultConnection objects are reconciled;
+// its Entries() method (see client_cache_entries.go) backs the `debug
+// client-cache` CLI.
+type ClientCache struct {
+	cache     map[ClientCacheKey]Client
+	cacheLock sync.RWMutex
+}
+
+// NewClientCache returns an empty ClientCache.
+func NewClientCache() *ClientCache {
+	return &ClientCache{cache: make(map[ClientCacheKey]Client)}
+}
+
+// Add computes client's ClientCacheKey and stores it, replacing any
+// existing entry under that key, then returns the key.
+func (c *ClientCache) Add(client Client) (ClientCacheKey, error) {
+	key, err := ComputeClientCacheKeyFromClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	c.cacheLock.Lock()
+	defer c.cacheLock.Unlock()
+	c.cache[key] = client
+
+	return key, nil
+}
+
+// Get returns the Client cached under key, if any.
+func (c *ClientCache) Get(key ClientCacheKey) (Client, bool) {
+	c.cacheLock.RLock()
+	defer c.cacheLock.RUnlock()
+
+	client, ok := c.cache[key]
+	return client, ok
+}
+
+// Remove evicts the Client cached under key, if any.
+func (c *ClientCache) Remove(key ClientCacheKey) {
+	c.cacheLock.Lock()
+	defer c.cacheLock.Unlock()
+	delete(c.cache, key)
+}
+
+const (
+	clientCacheKeyUIDLength = 36
+
+	// clientCacheKeyIdentityHashLength and clientCacheKeySpecHashLength
+	// are the lengths of the two hash segments making up a
+	// ClientCacheKey: "<method>-<identityHash>-<specHash>".
+	clientCacheKeyIdentityHashLength = 22
+	clientCacheKeySpecHashLength     = 8
+
+	// clientCacheKeyMaxLength bounds the generated key, before any clone
+	// namespace suffix is appended: a method of up to 40 characters plus
+	// the "-<identityHash>-<specHash>" segments. ClientCacheKeyClone does
+	// not re-check the length of the key it returns.
+	clientCacheKeyMaxLength = 40 + 1 + clientCacheKeyIdentityHashLength + 1 + clientCacheKeySpecHashLength
+)
+
+var (
+	errorInvalidUIDLength  = errors.New("invalid UID length")
+	errorDuplicateUID      = errors.New("duplicate UID")
+	errorKeyLengthExceeded = errors.New("client cache key length exceeded")
+)
+
+// ClientCacheKey uniquely identifies a Client held by the client cache.
+// Its canonical form is "<method>-<identityHash>-<specHash>"; a clone of
+// that entry appends a fourth, namespace-qualified segment:
+// "<method>-<identityHash>-<specHash>-<namespace>".
+type ClientCacheKey string
+
+// Client represents a cached Vault client.
+type Client interface {
+	GetVaultAuthObj() *secretsv1beta1.VaultAuth
+	GetVaultConnectionObj() *secretsv1beta1.VaultConnection
+	GetCredentialProvider() credentials.CredentialProvider
+	GetTokenSecret() *api.Secret
+}
+
+// defaultClient is the concrete Client implementation backing entries
+// in the client cache.
+type defaultClient struct {
+	authObj            *secretsv1beta1.VaultAuth
+	connObj            *secretsv1beta1.VaultConnection
+	credentialProvider credentials.CredentialProvider
+	tokenSecret        *api.Secret
+}
+
+func (c *defaultClient) GetVaultAuthObj() *secretsv1beta1.VaultAuth {
+	return c.authObj
+}
+
+func (c *defaultClient) GetVaultConnectionObj() *secretsv1beta1.VaultConnection {
+	return c.connObj
+}
+
+func (c *defaultClient) GetCredentialProvider() credentials.CredentialProvider {
+	return c.credentialProvider
+}
+
+func (c *defaultClient) GetTokenSecret() *api.Secret {
+	return c.tokenSecret
+}
+
+// computeClientCacheKey derives a ClientCacheKey from authObj, connObj,
+// and providerUID, as "<method>-<identityHash>-<specHash>". identityHash
+// is derived from the UIDs alone, as before; specHash is derived from
+// authObj.Generation and connObj.Generation, so editing a VaultAuth's or
+// VaultConnection's Spec invalidates the cached client/token even though
+// the underlying UIDs are unchanged, giving cache behavior the
+// optimistic-concurrency property expected of a Kubernetes controller.
+func computeClientCacheKey(authObj *secretsv1beta1.VaultAuth, connObj *secretsv1beta1.VaultConnection, providerUID types.UID) (ClientCacheKey, error) {
+	if authObj.UID == connObj.UID {
+		return "", errorDuplicateUID
+	}
+
+	for _, uid := range []types.UID{authObj.UID, connObj.UID, providerUID} {
+		if len(uid) != clientCacheKeyUIDLength {
+			return "", errorInvalidUIDLength
+		}
+	}
+
+	identitySum := sha256.Sum256([]byte(fmt.Sprintf("%s%s%s", authObj.UID, connObj.UID, providerUID)))
+	identityHash := hex.EncodeToString(identitySum[:])[:clientCacheKeyIdentityHashLength]
+
+	specSum := sha256.Sum256([]byte(fmt.Sprintf("%d%d", authObj.Generation, connObj.Generation)))
+	specHash := hex.EncodeToString(specSum[:])[:clientCacheKeySpecHashLength]
+
+	key := ClientCacheKey(fmt.Sprintf("%s-%s-%s", authObj.Spec.Method, identityHash, specHash))
+	if len(key) > clientCacheKeyMaxLength {
+		return "", fmt.Errorf("%w: length %d exceeds max %d", errorKeyLengthExceeded, len(key), clientCacheKeyMaxLength)
+	}
+
+	return key, nil
+}
+
+// ComputeClientCacheKeyFromClient derives c's ClientCacheKey from its
+// VaultAuth/VaultConnection objects and credential provider.
+func ComputeClientCacheKeyFromClient(c Client) (ClientCacheKey, error) {
+	authObj := c.GetVaultAuthObj()
+	connObj := c.GetVaultConnectionObj()
+	if authObj == nil || connObj == nil {
+		return "", fmt.Errorf("client is missing its VaultAuth/VaultConnection objects")
+	}
+
+	var providerUID types.UID
+	if cp := c.GetCredentialProvider(); cp != nil {
+		providerUID = cp.GetUID()
+	}
+
+	return computeClientCacheKey(authObj, connObj, providerUID)
+}
+
+// IsClone returns true if k was produced by ClientCacheKeyClone.
+func (k ClientCacheKey) IsClone() bool {
+	parts := strings.SplitN(string(k), "-", 4)
+	return len(parts) == 4 && parts[3] != ""
+}
+
+// ClientCacheKeyClone derives a clone of key scoped to namespace. key
+// must not itself be a clone.
+func ClientCacheKeyClone(key ClientCacheKey, namespace string) (ClientCacheKey, error) {
+	if namespace == "" {
+		return "", errors.New("namespace cannot be empty")
+	}
+	if key.IsClone() {
+		return "", errors.New("parent key cannot be a clone")
+	}
+
+	return ClientCacheKey(fmt.Sprintf("%s-%s", key, namespace)), nil
+}
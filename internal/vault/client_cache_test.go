@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	secretsv1beta1 "github.com/hashicorp/vault-secrets-operator/api/v1beta1"
+	"github.com/hashicorp/vault-secrets-operator/internal/vault/credentials"
+)
+
+func newTestClient() Client {
+	return &defaultClient{
+		authObj: &secretsv1beta1.VaultAuth{
+			ObjectMeta: metav1.ObjectMeta{UID: authUID},
+			Spec:       secretsv1beta1.VaultAuthSpec{Method: "ical"},
+		},
+		connObj: &secretsv1beta1.VaultConnection{
+			ObjectMeta: metav1.ObjectMeta{UID: connUID},
+		},
+		credentialProvider: credentials.NewKubernetesCredentialProvider(nil, "", providerUID),
+	}
+}
+
+func TestClientCache_AddGetRemove(t *testing.T) {
+	c := NewClientCache()
+
+	_, ok := c.Get("ical-" + identityHash + "-" + specHash)
+	assert.False(t, ok, "new cache should start empty")
+
+	client := newTestClient()
+	key, err := c.Add(client)
+	assert.NoError(t, err)
+	assert.Equal(t, ClientCacheKey("ical-"+identityHash+"-"+specHash), key)
+
+	got, ok := c.Get(key)
+	assert.True(t, ok)
+	assert.Same(t, client, got)
+
+	assert.Len(t, c.Entries(), 1)
+
+	c.Remove(key)
+	_, ok = c.Get(key)
+	assert.False(t, ok)
+	assert.Empty(t, c.Entries())
+}
+
+func TestClientCache_AddInvalid(t *testing.T) {
+	c := NewClientCache()
+
+	_, err := c.Add(&defaultClient{})
+	assert.Error(t, err)
+	assert.Empty(t, c.Entries())
+}
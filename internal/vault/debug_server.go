@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/go-logr/logr"
+)
+
+// DebugSocketPath is the default local admin socket the debug server
+// listens on and the `debug client-cache` CLI dials.
+const DebugSocketPath = "/tmp/vso-debug.sock"
+
+// ClientCacheIntrospector is implemented by the client cache to support
+// the `debug client-cache` CLI.
+type ClientCacheIntrospector interface {
+	Entries() []ClientCacheEntry
+}
+
+type debugRequest struct {
+	Command string `json:"command"` // "list"
+}
+
+// DebugServer exposes a read-only view of a ClientCacheIntrospector
+// over a local Unix domain socket, so operators can answer "which
+// Vault clients does VSO currently hold?" with `vault-secrets-operator
+// debug client-cache` instead of shelling into the pod.
+type DebugServer struct {
+	cache  ClientCacheIntrospector
+	logger logr.Logger
+}
+
+// NewDebugServer returns a DebugServer backed by cache.
+func NewDebugServer(cache ClientCacheIntrospector, logger logr.Logger) *DebugServer {
+	return &DebugServer{cache: cache, logger: logger}
+}
+
+// ListenAndServe listens on socketPath until ctx is canceled.
+func (s *DebugServer) ListenAndServe(ctx context.Context, socketPath string) error {
+	_ = os.Remove(socketPath)
+	ln, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return fmt.Errorf("failed to listen on debug socket %s: %w", socketPath, err)
+	}
+	defer ln.Close()
+
+	go func() {
+		<-ctx.Done()
+		ln.Close()
+	}()
+
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			select {
+			case <-ctx.Done():
+				return nil
+			default:
+				s.logger.Error(err, "debug server accept failed")
+				continue
+			}
+		}
+		go s.handleConn(conn)
+	}
+}
+
+func (s *DebugServer) handleConn(conn net.Conn) {
+	defer conn.Close()
+
+	var req debugRequest
+	if err := json.NewDecoder(conn).Decode(&req); err != nil {
+		s.logger.Error(err, "failed to decode debug request")
+		return
+	}
+
+	enc := json.NewEncoder(conn)
+	switch req.Command {
+	case "list":
+		_ = enc.Encode(s.cache.Entries())
+	default:
+		_ = enc.Encode(map[string]string{"error": fmt.Sprintf("unknown command %q", req.Command)})
+	}
+}